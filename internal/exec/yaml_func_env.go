@@ -26,7 +26,12 @@ func processTagEnv(
 	envVarDefault := ""
 	var envVarExists bool
 
-	parts, err := u.SplitStringByDelimiter(str, ' ')
+	var parts []string
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = u.SplitStringShellLike(str, nil)
+	} else {
+		parts, err = u.SplitStringByDelimiter(str, ' ')
+	}
 	if err != nil {
 		e := fmt.Errorf("error executing the YAML function: %s\n%v", input, err)
 		u.LogErrorAndExit(atmosConfig, e)
@@ -25,7 +25,12 @@ func processTagInclude(
 	var f string
 	q := ""
 
-	parts, err := u.SplitStringByDelimiter(str, ' ')
+	var parts []string
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = u.SplitStringShellLike(str, nil)
+	} else {
+		parts, err = u.SplitStringByDelimiter(str, ' ')
+	}
 	if err != nil {
 		e := fmt.Errorf("error executing the YAML function: !include %s\n%v", str, err)
 		log.Fatal(e)
@@ -74,9 +74,15 @@ func processTagTerraformOutputWithContext(
 	var output string
 
 	// Split the string into slices based on any whitespace (one or more spaces, tabs, or newlines),
-	// while also ignoring leading and trailing whitespace.
-	// SplitStringByDelimiter splits a string by the delimiter, not splitting inside quotes.
-	parts, err := u.SplitStringByDelimiter(str, ' ')
+	// while also ignoring leading and trailing whitespace. The opt-in Settings.ShellLikeArgParsing
+	// flag swaps in POSIX-ish shell word-splitting instead, for component/output names needing
+	// quoting or $VAR expansion.
+	var parts []string
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = u.SplitStringShellLike(str, nil)
+	} else {
+		parts, err = u.SplitStringByDelimiter(str, ' ')
+	}
 	if err != nil {
 		return nil, err
 	}
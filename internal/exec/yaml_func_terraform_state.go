@@ -40,9 +40,15 @@ func processTagTerraformStateWithContext(
 	var output string
 
 	// Split the string into slices based on any whitespace (one or more spaces, tabs, or newlines),
-	// while also ignoring leading and trailing whitespace.
-	// SplitStringByDelimiter splits a string by the delimiter, not splitting inside quotes.
-	parts, err := u.SplitStringByDelimiter(str, ' ')
+	// while also ignoring leading and trailing whitespace; see
+	// yaml_func_terraform_output.go's processTagTerraformOutputWithContext for the
+	// opt-in Settings.ShellLikeArgParsing behavior this mirrors.
+	var parts []string
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = u.SplitStringShellLike(str, nil)
+	} else {
+		parts, err = u.SplitStringByDelimiter(str, ' ')
+	}
 	errUtils.CheckErrorPrintAndExit(err, "", "")
 
 	partsLen := len(parts)
@@ -8,6 +8,7 @@ import (
 
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
 	"github.com/cloudposse/atmos/pkg/utils"
 )
 
@@ -30,13 +31,21 @@ func NewEnvFunction() *EnvFunction {
 }
 
 // parseEnvArgs parses the env function arguments into variable name and optional default.
-func parseEnvArgs(args string) (envVarName, envVarDefault string, err error) {
+// When atmosConfig has Settings.ShellLikeArgParsing enabled, arguments are split using
+// POSIX-ish shell word-splitting (utils.SplitStringShellLike) instead of CSV-style splitting,
+// so that defaults containing quoted spaces or $VAR references behave as users expect.
+func parseEnvArgs(args string, atmosConfig *schema.AtmosConfiguration) (envVarName, envVarDefault string, err error) {
 	args = strings.TrimSpace(args)
 	if args == "" {
 		return "", "", fmt.Errorf("%w: env function requires at least one argument", ErrInvalidArguments)
 	}
 
-	parts, err := utils.SplitStringByDelimiter(args, ' ')
+	var parts []string
+	if atmosConfig != nil && atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = utils.SplitStringShellLike(args, nil)
+	} else {
+		parts, err = utils.SplitStringByDelimiter(args, ' ')
+	}
 	if err != nil {
 		return "", "", fmt.Errorf("%w: %s", ErrInvalidArguments, args)
 	}
@@ -76,7 +85,12 @@ func (f *EnvFunction) Execute(ctx context.Context, args string, execCtx *Executi
 
 	log.Debug("Executing env function", "args", args)
 
-	envVarName, envVarDefault, err := parseEnvArgs(args)
+	var atmosConfig *schema.AtmosConfiguration
+	if execCtx != nil {
+		atmosConfig = execCtx.AtmosConfig
+	}
+
+	envVarName, envVarDefault, err := parseEnvArgs(args, atmosConfig)
 	if err != nil {
 		return "", err
 	}
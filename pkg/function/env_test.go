@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/schema"
 )
 
 func TestNewEnvFunction(t *testing.T) {
@@ -101,6 +103,44 @@ func TestEnvFunctionWithQuotedArgs(t *testing.T) {
 	assert.Equal(t, "default with spaces", result)
 }
 
+func TestEnvFunctionWithShellLikeArgParsing(t *testing.T) {
+	fn := NewEnvFunction()
+	atmosConfig := &schema.AtmosConfiguration{
+		Settings: schema.AtmosSettings{ShellLikeArgParsing: true},
+	}
+	execCtx := &ExecutionContext{AtmosConfig: atmosConfig}
+
+	// A default containing a quoted space only tokenizes to a single argument
+	// under shell-like splitting; CSV-style splitting on this same input would
+	// produce four fields and fail with an invalid-arguments error.
+	result, err := fn.Execute(context.Background(), `MY_VAR 'default with spaces'`, execCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "default with spaces", result)
+}
+
+func TestParseEnvArgs_ShellLikeArgParsing(t *testing.T) {
+	atmosConfig := &schema.AtmosConfiguration{
+		Settings: schema.AtmosSettings{ShellLikeArgParsing: true},
+	}
+
+	// $VAR expansion is only performed by the shell-like tokenizer, not the
+	// default CSV-style splitter, so this proves the opt-in branch actually ran.
+	t.Setenv("ATMOS_TEST_ENV_ARG_DEFAULT", "expanded-value")
+	name, def, err := parseEnvArgs(`MY_VAR "$ATMOS_TEST_ENV_ARG_DEFAULT"`, atmosConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "MY_VAR", name)
+	assert.Equal(t, "expanded-value", def)
+}
+
+func TestParseEnvArgs_DefaultsToCSVSplitting(t *testing.T) {
+	// With ShellLikeArgParsing unset (or nil atmosConfig), "$VAR" is passed
+	// through literally since CSV-style splitting has no variable expansion.
+	name, def, err := parseEnvArgs(`MY_VAR "$ATMOS_TEST_ENV_ARG_DEFAULT"`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "MY_VAR", name)
+	assert.Equal(t, "$ATMOS_TEST_ENV_ARG_DEFAULT", def)
+}
+
 func TestSplitStringByDelimiter(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -21,8 +21,18 @@ type terraformArgs struct {
 // Arguments can be either 2 or 3 parts:
 //   - 2 parts: component output_name (stack from context)
 //   - 3 parts: component stack output_name
+//
+// Honors the same Settings.ShellLikeArgParsing opt-in as parseEnvArgs in env.go.
 func parseTerraformArgs(args string, execCtx *ExecutionContext) (*terraformArgs, error) {
-	parts, err := utils.SplitStringByDelimiter(args, ' ')
+	var (
+		parts []string
+		err   error
+	)
+	if execCtx != nil && execCtx.AtmosConfig != nil && execCtx.AtmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = utils.SplitStringShellLike(args, nil)
+	} else {
+		parts, err = utils.SplitStringByDelimiter(args, ' ')
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +285,23 @@ type AtmosSettings struct {
 	Pro ProSettings `yaml:"pro,omitempty" json:"pro,omitempty" mapstructure:"pro"`
 	// Telemetry settings
 	Telemetry TelemetrySettings `yaml:"telemetry,omitempty" json:"telemetry,omitempty" mapstructure:"telemetry"`
+	// InternPool configures the bounded string-interning pool used to deduplicate common strings.
+	InternPool InternPoolSettings `yaml:"intern_pool,omitempty" json:"intern_pool,omitempty" mapstructure:"intern_pool"`
+	// ShellLikeArgParsing opts YAML function argument parsing (e.g. `!env`) into
+	// POSIX-ish shell word splitting (quote escapes, `$VAR` expansion) instead of
+	// the default CSV-based splitting.
+	ShellLikeArgParsing bool `yaml:"shell_like_arg_parsing,omitempty" json:"shell_like_arg_parsing,omitempty" mapstructure:"shell_like_arg_parsing"`
+}
+
+// InternPoolSettings configures the bounded, sharded string-interning pool in pkg/utils.
+// A zero value means "unbounded", preserving historical behavior.
+type InternPoolSettings struct {
+	// MaxBytes is the total byte budget across all shards. 0 means unlimited.
+	MaxBytes int64 `yaml:"max_bytes,omitempty" json:"max_bytes,omitempty" mapstructure:"max_bytes"`
+	// MaxEntries is the total entry budget across all shards. 0 means unlimited.
+	MaxEntries int64 `yaml:"max_entries,omitempty" json:"max_entries,omitempty" mapstructure:"max_entries"`
+	// BypassSizeBytes is the string length above which strings are never interned. 0 means no bypass.
+	BypassSizeBytes int `yaml:"bypass_size_bytes,omitempty" json:"bypass_size_bytes,omitempty" mapstructure:"bypass_size_bytes"`
 }
 
 // TelemetrySettings contains configuration for telemetry collection.
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// ErrUnterminatedQuote is returned by SplitStringShellLike when the input ends
+// while a quote is still open.
+var ErrUnterminatedQuote = errors.New("unterminated quote")
+
+// UnterminatedQuoteError identifies where an unterminated quote was opened so
+// callers can produce an actionable diagnostic.
+type UnterminatedQuoteError struct {
+	Quote  byte // The quote character that was never closed (' or ").
+	Line   int  // 1-based line on which the quote was opened.
+	Column int  // 1-based column on which the quote was opened.
+}
+
+func (e *UnterminatedQuoteError) Error() string {
+	return fmt.Sprintf("%s: %q opened at line %d, column %d", ErrUnterminatedQuote, e.Quote, e.Line, e.Column)
+}
+
+func (e *UnterminatedQuoteError) Unwrap() error {
+	return ErrUnterminatedQuote
+}
+
+// LookupEnvFunc resolves a variable name to its value, mirroring os.LookupEnv.
+type LookupEnvFunc func(name string) (string, bool)
+
+// SplitStringShellLike splits a command-line-like string into words using
+// POSIX-ish shell word-splitting rules:
+//   - Runs of unquoted whitespace separate words.
+//   - Single quotes preserve their contents literally; no escapes are recognized inside.
+//   - Double quotes allow the escapes \", \\, \$, and \` and interpolate $VAR / ${VAR}
+//     using the supplied lookup func (os.LookupEnv if lookup is nil).
+//   - A backslash outside quotes escapes the following byte literally.
+//   - An unterminated quote returns an *UnterminatedQuoteError identifying where it was opened.
+func SplitStringShellLike(input string, lookup LookupEnvFunc) ([]string, error) {
+	defer perf.Track(nil, "utils.SplitStringShellLike")()
+
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	var (
+		words        []string
+		word         strings.Builder
+		inWord       bool
+		line, column = 1, 0
+	)
+
+	runeLineCol := func(r byte) {
+		column++
+		if r == '\n' {
+			line++
+			column = 0
+		}
+	}
+
+	i := 0
+	for i < len(input) {
+		c := input[i]
+		runeLineCol(c)
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+			i++
+
+		case c == '\'':
+			startLine, startCol := line, column
+			inWord = true
+			i++
+			closed := false
+			for i < len(input) {
+				if input[i] == '\'' {
+					runeLineCol(input[i])
+					closed = true
+					i++
+					break
+				}
+				runeLineCol(input[i])
+				word.WriteByte(input[i])
+				i++
+			}
+			if !closed {
+				return nil, &UnterminatedQuoteError{Quote: '\'', Line: startLine, Column: startCol}
+			}
+
+		case c == '"':
+			startLine, startCol := line, column
+			inWord = true
+			i++
+			closed := false
+			for i < len(input) {
+				switch input[i] {
+				case '"':
+					runeLineCol(input[i])
+					closed = true
+					i++
+				case '\\':
+					runeLineCol(input[i])
+					i++
+					if i < len(input) {
+						switch input[i] {
+						case '"', '\\', '$', '`':
+							word.WriteByte(input[i])
+						default:
+							word.WriteByte('\\')
+							word.WriteByte(input[i])
+						}
+						runeLineCol(input[i])
+						i++
+					}
+					continue
+				case '$':
+					expanded, consumed := expandVariable(input[i:], lookup)
+					word.WriteString(expanded)
+					for j := 0; j < consumed; j++ {
+						runeLineCol(input[i])
+						i++
+					}
+					continue
+				default:
+					runeLineCol(input[i])
+					word.WriteByte(input[i])
+					i++
+					continue
+				}
+				if closed {
+					break
+				}
+			}
+			if !closed {
+				return nil, &UnterminatedQuoteError{Quote: '"', Line: startLine, Column: startCol}
+			}
+
+		case c == '\\':
+			i++
+			inWord = true
+			if i < len(input) {
+				runeLineCol(input[i])
+				word.WriteByte(input[i])
+				i++
+			}
+
+		default:
+			inWord = true
+			word.WriteByte(c)
+			i++
+		}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+// expandVariable expands a $VAR or ${VAR} reference at the start of s, returning
+// the expanded text and the number of input bytes consumed. If s does not start
+// with a valid variable reference, "$" is returned literally with 1 byte consumed.
+func expandVariable(s string, lookup LookupEnvFunc) (string, int) {
+	if len(s) < 2 {
+		return "$", 1
+	}
+
+	if s[1] == '{' {
+		end := strings.IndexByte(s, '}')
+		if end == -1 {
+			return "$", 1
+		}
+		name := s[2:end]
+		value, _ := lookup(name)
+		return value, end + 1
+	}
+
+	end := 1
+	for end < len(s) && isShellIdentifierByte(s[end]) {
+		end++
+	}
+	if end == 1 {
+		return "$", 1
+	}
+	name := s[1:end]
+	value, _ := lookup(name)
+	return value, end
+}
+
+func isShellIdentifierByte(b byte) bool {
+	return b == '_' ||
+		(b >= 'a' && b <= 'z') ||
+		(b >= 'A' && b <= 'Z') ||
+		(b >= '0' && b <= '9')
+}
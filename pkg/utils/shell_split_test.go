@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitStringShellLike(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		lookup    LookupEnvFunc
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:     "Simple split by space",
+			input:    "foo bar baz",
+			expected: []string{"foo", "bar", "baz"},
+		},
+		{
+			name:     "Single quotes preserve literally",
+			input:    `'foo $BAR \n baz'`,
+			expected: []string{`foo $BAR \n baz`},
+		},
+		{
+			name:     "Double quotes expand variables",
+			input:    `"bar $BAZ"`,
+			lookup:   func(name string) (string, bool) { return map[string]string{"BAZ": "qux"}[name], name == "BAZ" },
+			expected: []string{"bar qux"},
+		},
+		{
+			name:     "Double quotes expand braced variables",
+			input:    `"bar ${BAZ}!"`,
+			lookup:   func(name string) (string, bool) { return map[string]string{"BAZ": "qux"}[name], name == "BAZ" },
+			expected: []string{"bar qux!"},
+		},
+		{
+			name:     "Double quote escapes",
+			input:    `"a\"b\\c\$d"`,
+			expected: []string{`a"b\c$d`},
+		},
+		{
+			name:     "Backslash outside quotes escapes next byte",
+			input:    `foo\ bar`,
+			expected: []string{"foo bar"},
+		},
+		{
+			// Single quotes recognize zero escapes, so embedding a literal quote
+			// requires the standard POSIX idiom of closing the quote, escaping a
+			// bare quote outside it, then reopening: 'lit'\''eral' -> "lit'eral".
+			name:  "Mixed quoting example",
+			input: `foo "bar $BAZ" 'lit'\''eral' qux\ quux`,
+			lookup: func(name string) (string, bool) {
+				return map[string]string{"BAZ": "baz-value"}[name], name == "BAZ"
+			},
+			expected: []string{"foo", "bar baz-value", "lit'eral", "qux quux"},
+		},
+		{
+			name:      "Unterminated single quote",
+			input:     `foo 'bar`,
+			expectErr: true,
+		},
+		{
+			name:      "Unterminated double quote",
+			input:     `foo "bar`,
+			expectErr: true,
+		},
+		{
+			name:     "Unknown variable expands to empty",
+			input:    `"before $NOPE after"`,
+			lookup:   func(name string) (string, bool) { return "", false },
+			expected: []string{"before  after"},
+		},
+		{
+			name:     "Empty input",
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SplitStringShellLike(tt.input, tt.lookup)
+			if tt.expectErr {
+				assert.Error(t, err)
+				var unterminated *UnterminatedQuoteError
+				assert.ErrorAs(t, err, &unterminated)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestSplitStringShellLike_UnterminatedQuoteLocation(t *testing.T) {
+	_, err := SplitStringShellLike("foo 'bar", nil)
+	var unterminated *UnterminatedQuoteError
+	assert.ErrorAs(t, err, &unterminated)
+	assert.Equal(t, byte('\''), unterminated.Quote)
+	assert.Equal(t, 1, unterminated.Line)
+	assert.Equal(t, 5, unterminated.Column)
+}
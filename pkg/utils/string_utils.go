@@ -102,11 +102,43 @@ func SplitStringAtFirstOccurrence(s string, sep string) [2]string {
 	return [2]string{parts[0], parts[1]}
 }
 
+// Number of shards in the intern pool. Sharding reduces lock contention under
+// highly concurrent workloads (e.g. watch mode, language server, HTTP server mode)
+// by spreading strings across independent sub-pools, each with its own lock.
+const internShardCount = 16
+
+// internEntry is a single slot in a shard's pool.
+// recentlyUsed implements an approximate-LRU ("CLOCK") policy: it is set on every
+// hit and cleared during an eviction sweep, avoiding the cost of maintaining a
+// full doubly-linked LRU list on the hot read path.
+type internEntry struct {
+	value        string
+	recentlyUsed atomic.Bool
+}
+
+// internShard is one sub-pool of the intern pool, guarded by its own RWMutex.
+type internShard struct {
+	mu           sync.RWMutex
+	entries      map[string]*internEntry
+	currentBytes atomic.Int64
+	evictions    atomic.Int64
+	contention   atomic.Int64 // Number of writes that found the key already interned by another goroutine.
+}
+
 // String interning pool for deduplicating common strings.
 // This saves memory by ensuring duplicate strings share the same underlying storage.
+// The pool is split into internShardCount independent shards to avoid a single
+// lock becoming a bottleneck, and bounded by Settings.InternPool.MaxBytes/MaxEntries
+// in schema.AtmosConfiguration so long-running processes don't grow unbounded.
 var (
-	// The internPool stores interned strings using sync.Map for thread-safe concurrent access.
-	internPool sync.Map
+	internShards [internShardCount]*internShard
+
+	// internMaxBytes is the configured total byte budget across all shards (0 = unlimited).
+	internMaxBytes atomic.Int64
+	// internMaxEntries is the configured total entry budget across all shards (0 = unlimited).
+	internMaxEntries atomic.Int64
+	// internBypassSize is the string length above which strings are never interned (0 = no bypass).
+	internBypassSize atomic.Int64
 
 	// Atomic counters for string interning statistics (lock-free for high performance).
 	internStatsRequests atomic.Int64 // Total intern requests.
@@ -115,6 +147,35 @@ var (
 	internStatsSavedMem atomic.Int64 // Estimated memory saved (bytes).
 )
 
+func init() {
+	for i := range internShards {
+		internShards[i] = &internShard{entries: make(map[string]*internEntry)}
+	}
+}
+
+// internShardFor picks the shard for a given string using FNV-1a, which is cheap
+// and distributes short Atmos identifiers (keys, stack/component names) evenly.
+func internShardFor(s string) *internShard {
+	var h uint32 = 2166136261
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return internShards[h%internShardCount]
+}
+
+// configureInternPool applies the pool's byte/entry budgets and bypass threshold
+// from the Atmos configuration. It is cheap (a few atomic stores) so it is safe
+// to call on every Intern invocation.
+func configureInternPool(atmosConfig *schema.AtmosConfiguration) {
+	if atmosConfig == nil {
+		return
+	}
+	internMaxBytes.Store(atmosConfig.Settings.InternPool.MaxBytes)
+	internMaxEntries.Store(atmosConfig.Settings.InternPool.MaxEntries)
+	internBypassSize.Store(int64(atmosConfig.Settings.InternPool.BypassSizeBytes))
+}
+
 // Intern returns a canonical representation of the string.
 // If the string already exists in the intern pool, returns the existing instance.
 // Otherwise, adds the string to the pool and returns it.
@@ -125,41 +186,131 @@ var (
 //   - Stack names, component names, file paths
 //   - Common values: "true", "false", "default", region names
 //
+// Strings are distributed across internShardCount shards by hash, and each shard
+// is bounded by an approximate-LRU eviction policy once the pool's configured
+// Settings.InternPool.MaxBytes/MaxEntries budget is exceeded. Strings longer than
+// Settings.InternPool.BypassSizeBytes (when set) are returned as-is without being pinned in
+// the pool, since large YAML blobs are poor interning candidates.
+//
 // Thread-safe for concurrent use.
 // Note: perf.Track removed from this critical path function as it's called millions of times.
 // Statistics use atomic operations instead of locks to avoid contention in the hot path.
-func Intern(_ *schema.AtmosConfiguration, s string) string {
+func Intern(atmosConfig *schema.AtmosConfiguration, s string) string {
 	// Empty strings are not interned.
 	if s == "" {
 		return s
 	}
 
+	configureInternPool(atmosConfig)
+
+	if bypass := internBypassSize.Load(); bypass > 0 && int64(len(s)) > bypass {
+		return s
+	}
+
 	internStatsRequests.Add(1)
 
+	shard := internShardFor(s)
+
 	// Fast path: check if string is already interned.
-	if existing, ok := internPool.Load(s); ok {
+	shard.mu.RLock()
+	existing, ok := shard.entries[s]
+	if ok {
+		existing.recentlyUsed.Store(true)
+	}
+	shard.mu.RUnlock()
+	if ok {
 		internStatsHits.Add(1)
 		// Track memory saved (approximate: deduplicated string data length).
 		internStatsSavedMem.Add(int64(len(s)))
-		return existing.(string)
+		return existing.value
 	}
 
-	// Slow path: intern the string.
-	// Use LoadOrStore to handle race conditions where another goroutine
-	// might have interned the same string while we were checking.
-	actual, loaded := internPool.LoadOrStore(s, s)
+	// Slow path: intern the string under the shard's write lock.
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
 
-	if loaded {
-		// Another goroutine beat us to it.
+	// Another goroutine might have interned the same string while we waited for the lock.
+	if existing, ok := shard.entries[s]; ok {
+		shard.contention.Add(1)
+		existing.recentlyUsed.Store(true)
 		internStatsHits.Add(1)
-		// Track memory saved (approximate: deduplicated string data length).
 		internStatsSavedMem.Add(int64(len(s)))
-	} else {
-		// We successfully added a new string.
-		internStatsMisses.Add(1)
+		return existing.value
 	}
 
-	return actual.(string)
+	shard.entries[s] = &internEntry{value: s}
+	shard.currentBytes.Add(int64(len(s)))
+	internStatsMisses.Add(1)
+
+	shard.maybeEvict()
+
+	return s
+}
+
+// perShardBudget divides a configured total budget evenly across the shards, flooring
+// at 1 rather than 0 once the total is nonzero. Plain integer division would let any
+// total below internShardCount silently disable that budget dimension (e.g.
+// MaxEntries: 10 dividing to 0 and never evicting), which contradicts the documented
+// "never exceeds the budget by more than one shard's worth" guarantee.
+func perShardBudget(total int64) int64 {
+	if total <= 0 {
+		return 0
+	}
+	if perShard := total / internShardCount; perShard > 0 {
+		return perShard
+	}
+	return 1
+}
+
+// maybeEvict runs up to two CLOCK sweeps over the shard when it is over its configured
+// byte or entry budget. The first sweep evicts entries whose recently-used bit is already
+// clear and clears the bit on entries it spares instead of evicting them. The second sweep
+// only runs if the shard is still over budget, and is guaranteed to make progress: the
+// entire shard.mu write lock is held for both sweeps, and a reader can only set
+// recentlyUsed back to true after taking shard.mu.RLock (see Intern's fast path), so no bit
+// cleared in the first sweep can be re-set before the second sweep evicts it. This bounds
+// the sweep at two passes instead of spinning forever on a hot working set.
+// Must be called with shard.mu held for writing.
+func (shard *internShard) maybeEvict() {
+	maxBytes := internMaxBytes.Load()
+	maxEntries := internMaxEntries.Load()
+	if maxBytes <= 0 && maxEntries <= 0 {
+		return
+	}
+
+	shardMaxBytes := perShardBudget(maxBytes)
+	shardMaxEntries := perShardBudget(maxEntries)
+
+	overBudget := func() bool {
+		if shardMaxBytes > 0 && shard.currentBytes.Load() > shardMaxBytes {
+			return true
+		}
+		if shardMaxEntries > 0 && int64(len(shard.entries)) > shardMaxEntries {
+			return true
+		}
+		return false
+	}
+
+	if !overBudget() {
+		return
+	}
+
+	for pass := 0; pass < 2 && overBudget(); pass++ {
+		for key, entry := range shard.entries {
+			if !overBudget() {
+				return
+			}
+			if entry.recentlyUsed.Load() {
+				// Give it a second chance on the first pass; clear the bit so a
+				// still-over-budget second pass can evict it outright.
+				entry.recentlyUsed.Store(false)
+				continue
+			}
+			delete(shard.entries, key)
+			shard.currentBytes.Add(-int64(len(entry.value)))
+			shard.evictions.Add(1)
+		}
+	}
 }
 
 // InternSlice interns all strings in a slice.
@@ -195,22 +346,39 @@ func InternMapKeys(atmosConfig *schema.AtmosConfiguration, m map[string]any) map
 
 // InternStats represents string interning statistics.
 type InternStats struct {
-	Requests   int64 // Total intern requests.
-	Hits       int64 // Cache hits (string already interned).
-	Misses     int64 // Cache misses (new string added).
-	SavedBytes int64 // Estimated memory saved (bytes).
+	Requests        int64 // Total intern requests.
+	Hits            int64 // Cache hits (string already interned).
+	Misses          int64 // Cache misses (new string added).
+	SavedBytes      int64 // Estimated memory saved (bytes).
+	Evictions       int64 // Total entries evicted across all shards.
+	CurrentBytes    int64 // Total bytes currently pinned across all shards.
+	CurrentEntries  int64 // Total entries currently pinned across all shards.
+	ShardContention int64 // Number of writes that raced another goroutine for the same key.
 }
 
 // GetInternStats returns current interning statistics.
 // Useful for debugging and performance analysis.
-// Uses atomic loads for lock-free access.
+// Uses atomic loads for lock-free access, except for the per-shard entry count
+// which briefly takes each shard's read lock.
 func GetInternStats() InternStats {
-	return InternStats{
+	stats := InternStats{
 		Requests:   internStatsRequests.Load(),
 		Hits:       internStatsHits.Load(),
 		Misses:     internStatsMisses.Load(),
 		SavedBytes: internStatsSavedMem.Load(),
 	}
+
+	for _, shard := range internShards {
+		stats.Evictions += shard.evictions.Load()
+		stats.CurrentBytes += shard.currentBytes.Load()
+		stats.ShardContention += shard.contention.Load()
+
+		shard.mu.RLock()
+		stats.CurrentEntries += int64(len(shard.entries))
+		shard.mu.RUnlock()
+	}
+
+	return stats
 }
 
 // ResetInternStats resets interning statistics.
@@ -221,14 +389,20 @@ func ResetInternStats() {
 	internStatsHits.Store(0)
 	internStatsMisses.Store(0)
 	internStatsSavedMem.Store(0)
+	for _, shard := range internShards {
+		shard.evictions.Store(0)
+		shard.contention.Store(0)
+	}
 }
 
 // ClearInternPool clears the intern pool.
 // Should only be used in tests.
 func ClearInternPool() {
-	internPool.Range(func(key, value interface{}) bool {
-		internPool.Delete(key)
-		return true
-	})
+	for _, shard := range internShards {
+		shard.mu.Lock()
+		shard.entries = make(map[string]*internEntry)
+		shard.currentBytes.Store(0)
+		shard.mu.Unlock()
+	}
 	ResetInternStats()
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -741,6 +742,109 @@ func TestResetInternStats(t *testing.T) {
 	assert.Equal(t, int64(1), stats3.Hits, "Should be a hit - pool was not cleared")
 }
 
+// TestIntern_EvictionUnderByteBudget tests that the pool evicts entries once the
+// configured byte budget is exceeded, and never grows past it by more than one
+// shard's worth of data.
+func TestIntern_EvictionUnderByteBudget(t *testing.T) {
+	ClearInternPool()
+	defer ClearInternPool()
+	defer configureInternPool(&schema.AtmosConfiguration{})
+
+	const maxBytes = int64(internShardCount * 10) // 10 bytes/shard.
+	atmosConfig := &schema.AtmosConfiguration{
+		Settings: schema.AtmosSettings{
+			InternPool: schema.InternPoolSettings{MaxBytes: maxBytes},
+		},
+	}
+
+	for i := 0; i < 500; i++ {
+		Intern(atmosConfig, strings.Repeat("x", 4)+string(rune('a'+i%26))+string(rune('A'+i%5)))
+	}
+
+	stats := GetInternStats()
+	assert.Greater(t, stats.Evictions, int64(0), "Should have evicted entries once over budget")
+	assert.LessOrEqual(t, stats.CurrentBytes, maxBytes+10, "Pool should not exceed budget by more than one shard's worth")
+}
+
+// TestInternShard_MaybeEvictConvergesUnderHotWorkingSet reproduces the scenario where
+// every entry in a shard is repeatedly marked recently-used between eviction-triggering
+// inserts (the realistic case interning exists for). A single CLOCK pass only clears
+// recently-used bits on such entries without evicting them, so the shard can settle
+// well over budget and never converge. maybeEvict must bound this at two passes: the
+// first clears bits on a still-hot working set, the second evicts using those
+// now-cleared bits, since shard.mu is held for writing across the whole call.
+func TestInternShard_MaybeEvictConvergesUnderHotWorkingSet(t *testing.T) {
+	defer configureInternPool(&schema.AtmosConfiguration{})
+
+	const shardMaxBytes = int64(10)
+	internMaxBytes.Store(shardMaxBytes * internShardCount)
+	internMaxEntries.Store(0)
+
+	shard := &internShard{entries: make(map[string]*internEntry)}
+	for i := 0; i < 5; i++ {
+		key := strings.Repeat("k", 1) + string(rune('a'+i))
+		entry := &internEntry{value: strings.Repeat("x", 6)}
+		entry.recentlyUsed.Store(true) // Every entry is "hot" when eviction runs.
+		shard.entries[key] = entry
+		shard.currentBytes.Add(int64(len(entry.value)))
+	}
+
+	shard.maybeEvict()
+
+	assert.LessOrEqual(t, shard.currentBytes.Load(), shardMaxBytes,
+		"a hot working set must still converge to budget within maybeEvict's bounded passes")
+}
+
+// TestIntern_EvictionUnderSmallEntryBudget tests that a MaxEntries budget smaller than
+// internShardCount still evicts instead of silently disabling that budget dimension.
+// Plain integer division (maxEntries / internShardCount) floors to 0 for any total below
+// internShardCount, which overBudget() treated as "no limit" and never evicted at all.
+func TestIntern_EvictionUnderSmallEntryBudget(t *testing.T) {
+	ClearInternPool()
+	defer ClearInternPool()
+	defer configureInternPool(&schema.AtmosConfiguration{})
+
+	atmosConfig := &schema.AtmosConfiguration{
+		Settings: schema.AtmosSettings{
+			InternPool: schema.InternPoolSettings{MaxEntries: 10},
+		},
+	}
+
+	for i := 0; i < 1000; i++ {
+		Intern(atmosConfig, strings.Repeat("x", 4)+string(rune('a'+i%26))+string(rune('A'+i%10))+string(rune('0'+i%10)))
+	}
+
+	stats := GetInternStats()
+	assert.Greater(t, stats.Evictions, int64(0), "A small MaxEntries budget must still trigger evictions")
+	assert.LessOrEqual(t, stats.CurrentEntries, int64(10+internShardCount),
+		"Pool should not exceed the configured entry budget by more than one shard's worth")
+}
+
+// TestIntern_BypassLargeStrings tests that strings above the configured bypass
+// threshold are not pinned in the pool.
+func TestIntern_BypassLargeStrings(t *testing.T) {
+	ClearInternPool()
+	defer ClearInternPool()
+	defer configureInternPool(&schema.AtmosConfiguration{})
+
+	atmosConfig := &schema.AtmosConfiguration{
+		Settings: schema.AtmosSettings{
+			InternPool: schema.InternPoolSettings{BypassSizeBytes: 8},
+		},
+	}
+
+	small := Intern(atmosConfig, "short")
+	assert.Equal(t, "short", small)
+
+	large := strings.Repeat("y", 100)
+	result := Intern(atmosConfig, large)
+	assert.Equal(t, large, result)
+
+	stats := GetInternStats()
+	assert.Equal(t, int64(1), stats.Requests, "Bypassed strings should not count as intern requests")
+	assert.Equal(t, int64(1), stats.CurrentEntries, "Only the short string should be pinned")
+}
+
 // BenchmarkIntern_WithDuplicates benchmarks string interning with many duplicates (typical Atmos scenario).
 func BenchmarkIntern_WithDuplicates(b *testing.B) {
 	ClearInternPool()
@@ -7,12 +7,16 @@ import (
 
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
 )
 
 var ErrInvalidAtmosYAMLFunction = fmt.Errorf("invalid Atmos YAML function")
 
+// ProcessTagEnv processes the !env YAML function, honoring the same opt-in
+// Settings.ShellLikeArgParsing flag as pkg/function/env.go's parseEnvArgs.
 func ProcessTagEnv(
 	input string,
+	atmosConfig *schema.AtmosConfiguration,
 ) (string, error) {
 	defer perf.Track(nil, "utils.ProcessTagEnv")()
 
@@ -27,7 +31,12 @@ func ProcessTagEnv(
 	envVarDefault := ""
 	var envVarExists bool
 
-	parts, err := SplitStringByDelimiter(str, ' ')
+	var parts []string
+	if atmosConfig != nil && atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = SplitStringShellLike(str, nil)
+	} else {
+		parts, err = SplitStringByDelimiter(str, ' ')
+	}
 	if err != nil {
 		e := fmt.Errorf("%w: %s", ErrInvalidAtmosYAMLFunction, input)
 		return "", e
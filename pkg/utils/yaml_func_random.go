@@ -29,6 +29,11 @@ const (
 //	!random           -> random number 0-65535
 //	!random 100       -> random number 0-100
 //	!random 1024 65535 -> random number 1024-65535
+//
+// Note: !random's arguments are always plain integers, so unlike the other Atmos YAML
+// functions it has no opt-in shell-like parsing mode -- there's no quoting or variable
+// expansion for it to help with, and processRandomTag has no AtmosConfiguration in scope
+// to check a flag on anyway.
 func ProcessTagRandom(input string) (int, error) {
 	defer perf.Track(nil, "utils.ProcessTagRandom")()
 
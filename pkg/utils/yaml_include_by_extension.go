@@ -57,8 +57,15 @@ func processIncludeTagInternal(
 	var res any
 	var localFile string
 
-	// Parse the include arguments
-	parts, err := SplitStringByDelimiter(val, ' ')
+	// Parse the include arguments, honoring the same opt-in Settings.ShellLikeArgParsing
+	// flag as the rest of the Atmos YAML functions.
+	var parts []string
+	var err error
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = SplitStringShellLike(val, nil)
+	} else {
+		parts, err = SplitStringByDelimiter(val, ' ')
+	}
 	if err != nil {
 		return err
 	}
@@ -48,8 +48,15 @@ func processIncludeTagInternal(
 	var res any
 	var localFile string
 
-	// Parse the include arguments
-	parts, err := SplitStringByDelimiter(val, ' ')
+	// Parse the include arguments; see yaml_include_by_extension.go's
+	// processIncludeTagInternal for why this duplicate file does the same.
+	var parts []string
+	var err error
+	if atmosConfig.Settings.ShellLikeArgParsing {
+		parts, err = SplitStringShellLike(val, nil)
+	} else {
+		parts, err = SplitStringByDelimiter(val, ' ')
+	}
 	if err != nil {
 		return err
 	}